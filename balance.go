@@ -0,0 +1,136 @@
+package gorope
+
+import "math"
+
+// MaxImbalance bounds how much deeper than ideal a Rope may grow before
+// Insert, Delete and Concat opportunistically call Rebalance. It is the
+// multiplier c in the trigger condition depth > c * log2(Len()).
+var MaxImbalance float64 = 1.5
+
+// fibCache memoizes Fibonacci numbers as fib computes them, indexed by n.
+var fibCache = []int{0, 1}
+
+// fib returns the n-th Fibonacci number (fib(0)=0, fib(1)=1). It backs the
+// Boehm/Atkinson/Plass definition of a balanced rope of depth d: one whose
+// Len() is at least fib(d+2).
+func fib(n int) int {
+	for len(fibCache) <= n {
+		fibCache = append(fibCache, fibCache[len(fibCache)-1]+fibCache[len(fibCache)-2])
+	}
+	return fibCache[n]
+}
+
+// depth returns the height of the Rope: the number of edges on the longest
+// path down to a leaf. It reads the height field every node constructor
+// maintains rather than walking the tree, so it is O(1).
+func (r *Rope) depth() int {
+	if r == nil {
+		return 0
+	}
+	return r.height
+}
+
+// shouldRebalance reports whether r's depth has outgrown the ideal balanced
+// depth for its length by more than MaxImbalance, and is used by Insert,
+// Delete and Concat to decide whether to call Rebalance.
+func (r *Rope) shouldRebalance() bool {
+	n := r.Len()
+	if n <= 1 {
+		return false
+	}
+	return float64(r.depth()) > MaxImbalance*math.Log2(float64(n))
+}
+
+// join concatenates left and right into a plain internal node without
+// invoking the shouldRebalance check that the public Concat performs, so
+// that Rebalance's own rebuild doesn't recursively re-trigger itself.
+func join(left, right *Rope) *Rope {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	default:
+		return &Rope{
+			left:   left,
+			right:  right,
+			weight: left.Len(),
+			height: max(childHeight(left), childHeight(right)) + 1,
+		}
+	}
+}
+
+// Rebalance rebuilds the Rope into a balanced tree in place, following the
+// classic rope balancing algorithm: leaves are visited left to right and
+// slotted into a Fibonacci-indexed array S, where S[i] holds a balanced
+// subrope whose length lies in [fib(i+2), fib(i+3)). Placing a leaf x
+// repeatedly folds every occupied slot below the one it fits into onto its
+// left, clearing those slots, and re-derives which slot it fits into after
+// each such pass, since the folds can grow x past its previous slot's bound;
+// if that slot is already occupied, its content is joined onto x's left and
+// placement repeats. Once
+// every leaf has been placed, the remaining non-nil slots are concatenated
+// left to right. Rebalance has complexity of O(n).
+func (r *Rope) Rebalance() {
+	var slots []*Rope
+
+	insert := func(x *Rope) {
+		for {
+			i := 0
+			for x.Len() >= fib(i+3) {
+				i++
+			}
+			for len(slots) <= i {
+				slots = append(slots, nil)
+			}
+
+			folded := false
+			for j := 0; j < i; j++ {
+				if slots[j] != nil {
+					x = join(slots[j], x)
+					slots[j] = nil
+					folded = true
+				}
+			}
+			if folded {
+				continue
+			}
+
+			if slots[i] == nil {
+				slots[i] = x
+				return
+			}
+			x = join(slots[i], x)
+			slots[i] = nil
+		}
+	}
+
+	var walk func(n *Rope)
+	walk = func(n *Rope) {
+		if n == nil {
+			return
+		}
+		if n.left == nil && n.right == nil {
+			if n.weight > 0 {
+				insert(n.Copy())
+			}
+			return
+		}
+		walk(n.left)
+		walk(n.right)
+	}
+	walk(r)
+
+	// Slots fill low-to-high as content repeatedly outgrows its bucket, which
+	// means a lower surviving index always holds more recently placed (and
+	// thus further right) content than a higher one: finalize highest to
+	// lowest, each appended to the right of what's been assembled so far.
+	var result *Rope
+	for i := len(slots) - 1; i >= 0; i-- {
+		result = join(result, slots[i])
+	}
+	if result == nil {
+		result = &Rope{}
+	}
+	*r = *result
+}
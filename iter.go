@@ -0,0 +1,127 @@
+package gorope
+
+// Iter is a stateful cursor over the leaves of a Rope. It keeps an explicit
+// stack of the right subtrees still to be visited so that, unlike At, successive
+// reads do not re-descend from the root: Next and NextChunk are amortized
+// O(1) once the cursor is positioned.
+type Iter struct {
+	root  *Rope
+	stack []*Rope
+	leaf  *Rope
+	off   int
+	pos   int
+}
+
+// Iter returns a new Iter positioned at start. Iter has complexity of
+// O(log n).
+func (r *Rope) Iter(start int) *Iter {
+	it := &Iter{root: r}
+	it.Seek(start)
+	return it
+}
+
+// Seek repositions the Iter at pos, descending from the root and rebuilding
+// the stack of pending right subtrees along the way. Seek has complexity of
+// O(log n).
+func (it *Iter) Seek(pos int) {
+	it.stack = it.stack[:0]
+	it.pos = pos
+
+	node := it.root
+	for node != nil {
+		if node.left == nil && node.right == nil {
+			it.leaf = node
+			it.off = pos
+			return
+		}
+		if pos < node.weight {
+			if node.right != nil {
+				it.stack = append(it.stack, node.right)
+			}
+			node = node.left
+		} else {
+			pos -= node.weight
+			node = node.right
+		}
+	}
+	it.leaf = nil
+}
+
+// advance moves to the next leaf in order by popping the most recently
+// pushed subtree off the stack and descending its leftmost spine, pushing right
+// siblings as it goes. advance has amortized complexity of O(1).
+func (it *Iter) advance() bool {
+	for len(it.stack) > 0 {
+		node := it.stack[len(it.stack)-1]
+		it.stack = it.stack[:len(it.stack)-1]
+		for node != nil && (node.left != nil || node.right != nil) {
+			if node.right != nil {
+				it.stack = append(it.stack, node.right)
+			}
+			node = node.left
+		}
+		if node != nil {
+			it.leaf = node
+			it.off = 0
+			return true
+		}
+	}
+	it.leaf = nil
+	return false
+}
+
+// Next returns the next byte and advances the Iter. The second return value
+// is false once the Iter is exhausted. Next has amortized complexity of
+// O(1).
+func (it *Iter) Next() (byte, bool) {
+	for it.leaf != nil && it.off >= len(it.leaf.value) {
+		if !it.advance() {
+			return 0, false
+		}
+	}
+	if it.leaf == nil {
+		return 0, false
+	}
+
+	b := it.leaf.value[it.off]
+	it.off++
+	it.pos++
+	return b, true
+}
+
+// NextChunk returns the remainder of the current leaf's value and advances
+// the Iter past it. Callers that want byte-at-a-time semantics should use
+// Next; NextChunk exists for bulk consumers such as Bytes and ReadAt.
+// NextChunk has amortized complexity of O(1).
+func (it *Iter) NextChunk() ([]byte, bool) {
+	for it.leaf != nil && it.off >= len(it.leaf.value) {
+		if !it.advance() {
+			return nil, false
+		}
+	}
+	if it.leaf == nil {
+		return nil, false
+	}
+
+	chunk := it.leaf.value[it.off:]
+	it.pos += len(chunk)
+	it.off = len(it.leaf.value)
+	return chunk, true
+}
+
+// Bytes collects the Rope into a single pre-allocated []byte, filling it via
+// copy from each leaf's value instead of a quadratic string += build. Bytes
+// has complexity of O(n).
+func (r *Rope) Bytes() []byte {
+	buf := make([]byte, r.Len())
+	it := r.Iter(0)
+	n := 0
+	for {
+		chunk, ok := it.NextChunk()
+		if !ok {
+			break
+		}
+		n += copy(buf[n:], chunk)
+	}
+	return buf
+}
@@ -0,0 +1,172 @@
+package gorope
+
+import "fmt"
+
+// PersistentRope is an immutable sibling of Rope: Insert, Delete, Split and
+// Concat never modify the receiver, instead returning a new *PersistentRope
+// that shares every subtree the edit didn't touch, copying only the O(log n)
+// spine leading to the cut. Because leaves are already immutable []byte
+// slices, the only invariant this adds is that no code path may write into
+// an existing node's value, left or right after construction.
+type PersistentRope struct {
+	value  []byte
+	left   *PersistentRope
+	right  *PersistentRope
+	weight int
+}
+
+// NewPersistent creates a new PersistentRope out of a byte array and a
+// maximum number of characters in each node. NewPersistent has complexity of
+// O(n).
+func NewPersistent(value []byte, chunkSize int) *PersistentRope {
+	if len(value) <= chunkSize {
+		return &PersistentRope{weight: len(value), value: value}
+	}
+
+	mid := len(value) / 2
+	return &PersistentRope{
+		left:   NewPersistent(value[:mid], chunkSize),
+		right:  NewPersistent(value[mid:], chunkSize),
+		weight: mid,
+	}
+}
+
+// Len calculates and returns the length of the PersistentRope. Len has
+// complexity of O(log n).
+func (p *PersistentRope) Len() int {
+	sum := p.weight
+	if p.right != nil {
+		sum += p.right.Len()
+	}
+	return sum
+}
+
+// String collects the leaves and returns the string held by p. String has
+// complexity of O(n).
+func (p *PersistentRope) String() string {
+	value := string(p.value)
+	if p.left != nil {
+		value += p.left.String()
+	}
+	if p.right != nil {
+		value += p.right.String()
+	}
+	return value
+}
+
+// Bytes collects the leaves of p into a single pre-allocated []byte, filled
+// via copy rather than String's quadratic += build. Bytes has complexity of
+// O(n).
+func (p *PersistentRope) Bytes() []byte {
+	buf := make([]byte, p.Len())
+	n := 0
+
+	var walk func(node *PersistentRope)
+	walk = func(node *PersistentRope) {
+		if node == nil {
+			return
+		}
+		if node.left == nil && node.right == nil {
+			n += copy(buf[n:], node.value)
+			return
+		}
+		walk(node.left)
+		walk(node.right)
+	}
+	walk(p)
+	return buf
+}
+
+// Concat joins the callee with other into a new root, sharing both p and
+// other unchanged. Concat has complexity of O(1).
+func (p *PersistentRope) Concat(other *PersistentRope) *PersistentRope {
+	return &PersistentRope{
+		left:   p,
+		right:  other,
+		weight: p.Len(),
+	}
+}
+
+// Split splits p at pos, returning new left and right PersistentRopes that
+// share every subtree untouched by the cut. Split has complexity of
+// O(log n).
+func (p *PersistentRope) Split(pos int) (*PersistentRope, *PersistentRope) {
+	if p.left == nil && p.right == nil {
+		pos = min(pos, p.weight)
+		left := p.value[:pos]
+		right := p.value[pos:]
+		return &PersistentRope{value: left, weight: len(left)}, &PersistentRope{value: right, weight: len(right)}
+	}
+
+	if pos >= p.weight && p.right != nil {
+		rl, rr := p.right.Split(pos - p.weight)
+		return p.left.Concat(rl), rr
+	}
+
+	ll, lr := p.left.Split(pos)
+	return ll, lr.Concat(p.right)
+}
+
+// Insert returns a new PersistentRope with value inserted at pos, sharing
+// every subtree not on the path to pos. Error is non-nil if pos is
+// incorrect. Insert has complexity of O(log n).
+func (p *PersistentRope) Insert(pos int, value []byte) (*PersistentRope, error) {
+	if pos < 0 || pos > p.Len() {
+		return nil, fmt.Errorf("incorrect split position")
+	}
+
+	left, right := p.Split(pos)
+	n := &PersistentRope{value: value, weight: len(value)}
+	return left.Concat(n).Concat(right), nil
+}
+
+// Delete returns a new PersistentRope with n characters removed starting at
+// pos, sharing every subtree not on the path to the cut. Delete has
+// complexity of O(log n).
+func (p *PersistentRope) Delete(pos int, n int) (*PersistentRope, error) {
+	left, rest := p.Split(pos)
+	n = min(n, rest.Len())
+	_, right := rest.Split(n)
+	return left.Concat(right), nil
+}
+
+// Snapshot returns the PersistentRope's current version. Because
+// PersistentRope is already immutable, retaining p itself is a safe way to
+// pin a version against future edits, which always produce new roots rather
+// than mutating existing ones. Snapshot has complexity of O(1).
+func (p *PersistentRope) Snapshot() *PersistentRope {
+	return p
+}
+
+// Edit describes a single contiguous change between two PersistentRope
+// versions: the characters in [Pos, Pos+Removed) of the old version were
+// replaced by Inserted in the new one.
+type Edit struct {
+	Pos      int
+	Removed  int
+	Inserted []byte
+}
+
+// Diff reports the edit that turns old into new, as the single contiguous
+// range outside their common prefix and suffix. It returns nil if old and
+// new hold the same contents. Diff has complexity of O(n).
+func Diff(old, new *PersistentRope) []Edit {
+	a, b := old.Bytes(), new.Bytes()
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	removed := len(a) - prefix - suffix
+	inserted := b[prefix : len(b)-suffix]
+	if removed == 0 && len(inserted) == 0 {
+		return nil
+	}
+	return []Edit{{Pos: prefix, Removed: removed, Inserted: inserted}}
+}
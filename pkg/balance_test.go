@@ -0,0 +1,49 @@
+package pkg
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFib(t *testing.T) {
+	want := []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}
+	for n, w := range want {
+		if got := fib(n); got != w {
+			t.Errorf("fib(%v) got %v; want %v", n, got, w)
+		}
+	}
+}
+
+func TestRope_Rebalance(t *testing.T) {
+	rope := FromStringWith(text, chunk)
+	for i := 0; i < 50; i++ {
+		if err := rope.Insert(0, []byte("x")); err != nil {
+			t.Errorf("unexpected error in insert operation %v", err)
+		}
+	}
+
+	want := string(bytes.Repeat([]byte("x"), 50)) + text
+	rope.Rebalance()
+	if rope.String() != want {
+		t.Errorf("got %v; want %v", rope.String(), want)
+	}
+
+	if rope.depth() > int(MaxImbalance*3)+3 {
+		t.Errorf("rope is still imbalanced after Rebalance: depth %v, len %v", rope.depth(), rope.Len())
+	}
+}
+
+func BenchmarkRope_AtAfterSequentialInserts(b *testing.B) {
+	rope := FromStringWith(text, chunk)
+	for i := 0; i < 10_000; i++ {
+		if err := rope.Insert(0, []byte("x")); err != nil {
+			b.Errorf("unexpected error in insert operation %v", err)
+		}
+	}
+
+	length := rope.Len()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = rope.At(i % length)
+	}
+}
@@ -0,0 +1,60 @@
+package pkg
+
+import "testing"
+
+func TestRope_IterNext(t *testing.T) {
+	rope := FromStringWith(text, chunk)
+	it := rope.Iter(0)
+	for i, c := range text {
+		got, ok := it.Next()
+		if !ok {
+			t.Errorf("unexpected end of iterator at %v", i)
+		}
+		if rune(got) != c {
+			t.Errorf("value got %v; want %v", got, c)
+		}
+	}
+
+	if _, ok := it.Next(); ok {
+		t.Errorf("expected iterator to be exhausted")
+	}
+}
+
+func TestRope_IterSeek(t *testing.T) {
+	rope := FromStringWith(text, chunk)
+	it := rope.Iter(0)
+	for pos := range text {
+		it.Seek(pos)
+		got, ok := it.Next()
+		if !ok {
+			t.Errorf("unexpected end of iterator at %v", pos)
+		}
+		if got != text[pos] {
+			t.Errorf("value got %v; want %v", got, text[pos])
+		}
+	}
+}
+
+func TestRope_IterNextChunk(t *testing.T) {
+	rope := FromStringWith(text, chunk)
+	it := rope.Iter(0)
+	got := make([]byte, 0, len(text))
+	for {
+		chunk, ok := it.NextChunk()
+		if !ok {
+			break
+		}
+		got = append(got, chunk...)
+	}
+
+	if string(got) != text {
+		t.Errorf("got %v; want %v", string(got), text)
+	}
+}
+
+func TestRope_Bytes(t *testing.T) {
+	rope := FromStringWith(text, chunk)
+	if string(rope.Bytes()) != text {
+		t.Errorf("got %v; want %v", string(rope.Bytes()), text)
+	}
+}
@@ -0,0 +1,120 @@
+package pkg
+
+import "testing"
+
+func TestPersistentRope_String(t *testing.T) {
+	got := NewPersistent([]byte(text), chunk)
+	if got.String() != text {
+		t.Errorf("got %v; want %v", got.String(), text)
+	}
+}
+
+func TestPersistentRope_Concat(t *testing.T) {
+	left := NewPersistent([]byte(text[:chunk]), chunk/2)
+	right := NewPersistent([]byte(text[chunk:]), chunk/2)
+	got := left.Concat(right)
+
+	if left.String() != text[:chunk] {
+		t.Errorf("unexpected left modification; got %v; want %v", left.String(), text[:chunk])
+	}
+	if right.String() != text[chunk:] {
+		t.Errorf("unexpected right modification; got %v; want %v", right.String(), text[chunk:])
+	}
+	if got.String() != text {
+		t.Errorf("got %v; want %v", got.String(), text)
+	}
+}
+
+func TestPersistentRope_Split(t *testing.T) {
+	rope := NewPersistent([]byte(text), chunk)
+	for pos := range text {
+		left, right := rope.Split(pos)
+
+		if left.String() != text[:pos] {
+			t.Errorf("left got %v; want %v", left.String(), text[:pos])
+		}
+		if right.String() != text[pos:] {
+			t.Errorf("right got %v; want %v", right.String(), text[pos:])
+		}
+		if rope.String() != text {
+			t.Errorf("unexpected mutation of original; got %v; want %v", rope.String(), text)
+		}
+	}
+}
+
+func TestPersistentRope_Insert(t *testing.T) {
+	rope := NewPersistent([]byte(text), chunk)
+	banana := "Banana!"
+	for pos := range text {
+		want := text[:pos] + banana + text[pos:]
+		got, err := rope.Insert(pos, []byte(banana))
+		if err != nil {
+			t.Errorf("unexpected error in insert operation %v", err)
+		}
+		if got.String() != want {
+			t.Errorf("got %v; want %v", got.String(), want)
+		}
+		if rope.String() != text {
+			t.Errorf("unexpected mutation of original; got %v; want %v", rope.String(), text)
+		}
+	}
+}
+
+func TestPersistentRope_Delete(t *testing.T) {
+	rope := NewPersistent([]byte(text), chunk)
+	ns := []int{1, 2, 3}
+	for _, n := range ns {
+		for pos := range text {
+			want := text[:pos] + text[min(pos+n, len(text)):]
+			got, err := rope.Delete(pos, n)
+			if err != nil {
+				t.Errorf("unexpected error in delete operation %v", err)
+			}
+			if got.String() != want {
+				t.Errorf("got %v; want %v", got.String(), want)
+			}
+			if rope.String() != text {
+				t.Errorf("unexpected mutation of original; got %v; want %v", rope.String(), text)
+			}
+		}
+	}
+}
+
+func TestPersistentRope_Snapshot(t *testing.T) {
+	rope := NewPersistent([]byte(text), chunk)
+	snap := rope.Snapshot()
+
+	edited, err := rope.Insert(0, []byte("Banana!"))
+	if err != nil {
+		t.Errorf("unexpected error in insert operation %v", err)
+	}
+
+	if snap.String() != text {
+		t.Errorf("snapshot changed; got %v; want %v", snap.String(), text)
+	}
+	if edited.String() == snap.String() {
+		t.Errorf("edited version should differ from snapshot")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := NewPersistent([]byte(text), chunk)
+	newRope, err := old.Insert(5, []byte("Banana!"))
+	if err != nil {
+		t.Errorf("unexpected error in insert operation %v", err)
+	}
+
+	edits := Diff(old, newRope)
+	if len(edits) != 1 {
+		t.Fatalf("got %v edits; want 1", len(edits))
+	}
+
+	edit := edits[0]
+	if edit.Pos != 5 || edit.Removed != 0 || string(edit.Inserted) != "Banana!" {
+		t.Errorf("got %+v; want {Pos:5 Removed:0 Inserted:Banana!}", edit)
+	}
+
+	if edits := Diff(old, old); edits != nil {
+		t.Errorf("got %v; want nil for identical versions", edits)
+	}
+}
@@ -0,0 +1,73 @@
+package pkg
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRope_WriteTo(t *testing.T) {
+	rope := FromStringWith(text, chunk)
+	var buf bytes.Buffer
+
+	n, err := rope.WriteTo(&buf)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if n != int64(len(text)) {
+		t.Errorf("got %v; want %v", n, len(text))
+	}
+	if buf.String() != text {
+		t.Errorf("got %v; want %v", buf.String(), text)
+	}
+}
+
+func TestRope_ReadFrom(t *testing.T) {
+	rope := FromStringWith("", chunk)
+	n, err := rope.ReadFrom(strings.NewReader(text))
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if n != int64(len(text)) {
+		t.Errorf("got %v; want %v", n, len(text))
+	}
+	if rope.String() != text {
+		t.Errorf("got %v; want %v", rope.String(), text)
+	}
+}
+
+func TestRope_ReadAt(t *testing.T) {
+	rope := FromStringWith(text, chunk)
+	for pos := range text {
+		p := make([]byte, len(text)-pos)
+		n, err := rope.ReadAt(p, int64(pos))
+		if err != nil && err != io.EOF {
+			t.Errorf("unexpected error %v", err)
+		}
+		if string(p[:n]) != text[pos:] {
+			t.Errorf("got %v; want %v", string(p[:n]), text[pos:])
+		}
+	}
+
+	_, err := rope.ReadAt(make([]byte, 1), -1)
+	if err == nil {
+		t.Errorf("expected error upon negative offset")
+	}
+
+	_, err = rope.ReadAt(make([]byte, 1), int64(len(text)))
+	if err != io.EOF {
+		t.Errorf("expected io.EOF at end of rope, got %v", err)
+	}
+}
+
+func TestRope_NewReader(t *testing.T) {
+	rope := FromStringWith(text, chunk)
+	got, err := io.ReadAll(NewReader(rope))
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if string(got) != text {
+		t.Errorf("got %v; want %v", string(got), text)
+	}
+}
@@ -0,0 +1,148 @@
+package gorope
+
+import (
+	"fmt"
+	"io"
+)
+
+// defaultReadChunkSize is the leaf size ReadFrom uses when turning the bytes
+// read from an io.Reader into new Rope nodes.
+const defaultReadChunkSize = 4096
+
+// WriteTo writes the Rope to w by walking the leaves in order and calling
+// w.Write on each leaf's value, so streaming a Rope out never materializes
+// its full contents into an intermediate []byte. WriteTo has complexity of
+// O(n).
+func (r *Rope) WriteTo(w io.Writer) (int64, error) {
+	if r.left == nil && r.right == nil {
+		n, err := w.Write(r.value)
+		return int64(n), err
+	}
+
+	var written int64
+	if r.left != nil {
+		n, err := r.left.WriteTo(w)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	if r.right != nil {
+		n, err := r.right.WriteTo(w)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// concatLeaves merges a slice of leaves into a single balanced Rope via
+// bottom-up pairwise Concat, or returns nil if leaves is empty.
+func concatLeaves(leaves []*Rope) *Rope {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	for len(leaves) > 1 {
+		next := make([]*Rope, 0, (len(leaves)+1)/2)
+		for i := 0; i < len(leaves); i += 2 {
+			if i+1 < len(leaves) {
+				next = append(next, leaves[i].Concat(leaves[i+1]))
+			} else {
+				next = append(next, leaves[i])
+			}
+		}
+		leaves = next
+	}
+	return leaves[0]
+}
+
+// ReadFrom reads reader in chunks of defaultReadChunkSize, builds a balanced
+// tree bottom-up out of the resulting leaves and appends it to the callee.
+// ReadFrom has complexity of O(m log m) where m is the number of chunks
+// read.
+func (r *Rope) ReadFrom(reader io.Reader) (int64, error) {
+	var leaves []*Rope
+	var total int64
+	for {
+		buf := make([]byte, defaultReadChunkSize)
+		n, err := reader.Read(buf)
+		if n > 0 {
+			leaves = append(leaves, &Rope{value: buf[:n], weight: n})
+			total += int64(n)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+
+	if read := concatLeaves(leaves); read != nil {
+		*r = *r.Concat(read)
+	}
+	return total, nil
+}
+
+// ReadAt fills p with the bytes of the Rope starting at off, satisfying
+// io.ReaderAt. ReadAt has complexity of O(log n + len(p)).
+func (r *Rope) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("cannot read at negative offset %v", off)
+	}
+	if off >= int64(r.Len()) {
+		return 0, io.EOF
+	}
+
+	it := r.Iter(int(off))
+	n := 0
+	for n < len(p) {
+		chunk, ok := it.NextChunk()
+		if !ok {
+			break
+		}
+		n += copy(p[n:], chunk)
+	}
+
+	var err error
+	if n < len(p) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// reader adapts an Iter into a stateful io.Reader, buffering the unread tail
+// of the current chunk between calls to Read.
+type reader struct {
+	it      *Iter
+	pending []byte
+}
+
+// NewReader returns an io.Reader that streams the Rope's contents from the
+// start, backed by an Iter so repeated reads are amortized O(1) per chunk
+// instead of re-descending the tree.
+func NewReader(r *Rope) io.Reader {
+	return &reader{it: r.Iter(0)}
+}
+
+// Read implements io.Reader by filling p from the pending chunk, fetching a
+// new one from the underlying Iter once it is exhausted.
+func (rd *reader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if len(rd.pending) == 0 {
+		chunk, ok := rd.it.NextChunk()
+		if !ok {
+			return 0, io.EOF
+		}
+		rd.pending = chunk
+	}
+
+	n := copy(p, rd.pending)
+	rd.pending = rd.pending[n:]
+	return n, nil
+}
@@ -15,6 +15,18 @@ type Rope struct {
 	left   *Rope
 	right  *Rope
 	weight int
+	height int
+}
+
+// childHeight returns n's height, or -1 if n is nil, so that an internal
+// node's height can be computed uniformly as
+// max(childHeight(left), childHeight(right)) + 1 regardless of whether
+// either child is present.
+func childHeight(n *Rope) int {
+	if n == nil {
+		return -1
+	}
+	return n.height
 }
 
 // NewWith creates a new Rope out a byte array and a maximum number
@@ -31,13 +43,14 @@ func NewWith(value []byte, chunkSize int) *Rope {
 	}
 
 	mid := len(value) / 2
-	left := value[:mid]
-	right := value[mid:]
+	left := NewWith(value[:mid], chunkSize)
+	right := NewWith(value[mid:], chunkSize)
 	return &Rope{
-		left:   NewWith(left, chunkSize),
-		right:  NewWith(right, chunkSize),
-		weight: len(left),
+		left:   left,
+		right:  right,
+		weight: mid,
 		value:  nil,
+		height: max(childHeight(left), childHeight(right)) + 1,
 	}
 }
 
@@ -59,6 +72,27 @@ func FromString(value string) *Rope {
 	return New([]byte(value))
 }
 
+// concatRaw joins r with other into successive plain internal nodes,
+// without the shouldRebalance check the public Concat performs. Split,
+// Insert and Delete use it for their own internal assembly so that only the
+// top-level call's single check can trigger a Rebalance, instead of every
+// step along their O(log n) spine re-triggering one of its own.
+func concatRaw(r *Rope, other ...*Rope) *Rope {
+	rope := r
+	for _, other := range other {
+		left := rope.Copy()
+		right := other.Copy()
+		rope = &Rope{
+			left:   left,
+			right:  right,
+			weight: left.Len(),
+			value:  nil,
+			height: max(childHeight(left), childHeight(right)) + 1,
+		}
+	}
+	return rope
+}
+
 // Concat joins multiple instances of Rope and returns the root of the combination.
 // This method simply assigns new pointers of the same data to the root, so it
 // does not modify the caller or the callee. Concat has complexity of O(1).
@@ -67,14 +101,9 @@ func (r *Rope) Concat(other ...*Rope) *Rope {
 		return r
 	}
 
-	rope := r
-	for _, other := range other {
-		rope = &Rope{
-			left:   rope.Copy(),
-			right:  other.Copy(),
-			weight: rope.Len(),
-			value:  nil,
-		}
+	rope := concatRaw(r, other...)
+	if rope.shouldRebalance() {
+		rope.Rebalance()
 	}
 	return rope
 }
@@ -85,10 +114,13 @@ func (r *Rope) Concat(other ...*Rope) *Rope {
 func (r *Rope) Split(pos int) *Rope {
 	orphans := &Rope{}
 	if pos >= r.weight && r.right != nil {
-		orphans = orphans.Concat(r.right.Split(pos - r.weight))
+		orphans = concatRaw(orphans, r.right.Split(pos-r.weight))
+		r.height = max(childHeight(r.left), childHeight(r.right)) + 1
 	} else if r.left != nil {
-		orphans = orphans.Concat(r.left.Split(pos), r.right)
+		orphans = concatRaw(orphans, r.left.Split(pos), r.right)
 		r.right = nil
+		r.weight = r.left.Len()
+		r.height = max(childHeight(r.left), childHeight(r.right)) + 1
 	} else {
 		// We split the node
 		pos = min(pos, r.weight)
@@ -99,6 +131,7 @@ func (r *Rope) Split(pos int) *Rope {
 		*r = Rope{
 			left:   &Rope{value: left, weight: len(left)},
 			weight: len(left),
+			height: 1,
 		}
 
 		// Return the right child
@@ -115,16 +148,20 @@ func (r *Rope) Split(pos int) *Rope {
 func (r *Rope) Insert(pos int, value []byte) error {
 	n := &Rope{value: value, weight: len(value)}
 	if pos == 0 {
-		n = n.Concat(r)
+		n = concatRaw(n, r)
 		*r = *n
 	} else if pos <= r.Len() {
 		other := r.Split(pos)
-		n = r.Concat(n)
-		other = n.Concat(other)
+		n = concatRaw(r, n)
+		other = concatRaw(n, other)
 		*r = *other
 	} else {
 		return fmt.Errorf("incorrect split position")
 	}
+
+	if r.shouldRebalance() {
+		r.Rebalance()
+	}
 	return nil
 }
 
@@ -137,7 +174,10 @@ func (r *Rope) Delete(pos int, n int) error {
 	n = min(n, lhs.Len())
 	rhs := lhs.Split(n)
 
-	*r = *r.Concat(rhs)
+	*r = *concatRaw(r, rhs)
+	if r.shouldRebalance() {
+		r.Rebalance()
+	}
 	return nil
 }
 
@@ -184,6 +224,10 @@ func (r *Rope) Clone() *Rope {
 // Len calculates and returns the length of the rope (sum of all the
 // characters). Len has complexity of O(log n).
 func (r *Rope) Len() int {
+	if r.left == nil && r.right == nil {
+		return len(r.value)
+	}
+
 	sum := r.weight
 	if r.right != nil {
 		sum += r.right.Len()
@@ -191,16 +235,9 @@ func (r *Rope) Len() int {
 	return sum
 }
 
-// String collects the leaves and returns the []byte held by Rope.
+// String collects the leaves and returns the string held by Rope, via Bytes
+// so the build is a single pre-allocated copy rather than a quadratic +=.
 // String has complexity of O(n).
 func (r *Rope) String() string {
-	value := string(r.value)
-	if r.left != nil {
-		value += r.left.String()
-	}
-
-	if r.right != nil {
-		value += r.right.String()
-	}
-	return value
+	return string(r.Bytes())
 }